@@ -21,12 +21,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
@@ -35,6 +39,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
@@ -53,8 +59,22 @@ import (
 const (
 	// ControllerName is the controller name that will be used when reporting events.
 	ControllerName = "execution-controller"
+
+	// eventProbeNamespace is the namespace the startup dry-run Event probe is issued against.
+	eventProbeNamespace = metav1.NamespaceDefault
 )
 
+// eventFallbackTotal counts events that were routed to the log-only sink because the
+// startup probe found that the controller has no permission to create events.
+var eventFallbackTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "karmada_execution_controller_event_fallback_total",
+	Help: "Number of Work sync events that were logged instead of recorded because the execution controller lacks events permission.",
+})
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(eventFallbackTotal)
+}
+
 // Controller is to sync Work.
 type Controller struct {
 	client.Client      // used to operate Work resources.
@@ -64,6 +84,23 @@ type Controller struct {
 	PredicateFunc      predicate.Predicate
 	InformerManager    genericmanager.MultiClusterInformerManager
 	RatelimiterOptions ratelimiterflag.Options
+	// ApplyStrategy determines whether manifests are reconciled via a client-side
+	// GET-then-Create/Update (the default) or a Server-Side Apply patch.
+	ApplyStrategy objectwatcher.ApplyStrategy
+	// ForceApply controls the force-conflicts behavior of a ServerSideApply ApplyStrategy.
+	// It has no effect when ApplyStrategy is ClientSideUpdate.
+	//
+	// Operators switching an already-synced Work from ClientSideUpdate to ServerSideApply
+	// should set this to true for the transition: every field is still owned by the prior
+	// Update-based manager, so the first unforced apply is rejected with a conflict until
+	// ForceApply hands ownership over to karmada-execution-controller.
+	ForceApply bool
+	// KubeClientSet is a typed client used to own a dedicated EventBroadcaster and to
+	// probe whether the controller has permission to create events, instead of relying
+	// on the shared broadcaster every other controller in the manager is wired to.
+	KubeClientSet kubernetes.Interface
+
+	eventBroadcaster record.EventBroadcaster
 }
 
 // Reconcile performs a full reconciliation for the object referred to by the Request.
@@ -119,6 +156,10 @@ func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Reques
 
 // SetupWithManager creates a controller and register to controller manager.
 func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	if err := c.setupEventRecorder(mgr); err != nil {
+		return err
+	}
+
 	return controllerruntime.NewControllerManagedBy(mgr).
 		For(&workv1alpha1.Work{}, builder.WithPredicates(c.PredicateFunc)).
 		WithEventFilter(predicate.GenerationChangedPredicate{}).
@@ -128,6 +169,60 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 		Complete(c)
 }
 
+// setupEventRecorder gives the execution controller its own EventBroadcaster, rather than
+// reusing the manager's shared one, so a restricted member cluster RBAC (or a sandboxed
+// control plane namespace) can't turn a noisy "unable to write event" loop on this
+// broadcaster into a shared failure mode for every other controller in the process.
+func (c *Controller) setupEventRecorder(mgr controllerruntime.Manager) error {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartLogging(klog.V(4).Infof)
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: c.KubeClientSet.CoreV1().Events("")})
+	c.eventBroadcaster = broadcaster
+	c.EventRecorder = broadcaster.NewRecorder(mgr.GetScheme(), corev1.EventSource{Component: ControllerName})
+
+	if !c.probeEventPermission() {
+		klog.Warningf("%s lacks permission to create events (events.k8s.io); falling back to a log-only event recorder", ControllerName)
+		broadcaster.Shutdown()
+		c.eventBroadcaster = nil
+		c.EventRecorder = &logOnlyEventRecorder{}
+	}
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		if c.eventBroadcaster != nil {
+			c.eventBroadcaster.Shutdown()
+		}
+		return nil
+	}))
+}
+
+// probeEventPermission issues a dry-run Event create against the Karmada control plane to
+// check whether the controller's service account has create permission on events, without
+// actually persisting a probe object.
+func (c *Controller) probeEventPermission() bool {
+	probe := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "karmada-execution-controller-event-probe-",
+			Namespace:    eventProbeNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Namespace", Name: eventProbeNamespace},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "EventPermissionProbe",
+		Message:        "karmada-execution-controller checking for events create permission",
+	}
+
+	_, err := c.KubeClientSet.CoreV1().Events(eventProbeNamespace).Create(context.TODO(), probe, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err == nil {
+		return true
+	}
+	if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
+		return false
+	}
+
+	klog.Warningf("Event permission probe for %s returned an unexpected error, assuming events are usable: %v", ControllerName, err)
+	return true
+}
+
 func (c *Controller) syncWork(clusterName string, work *workv1alpha1.Work) (controllerruntime.Result, error) {
 	start := time.Now()
 	err := c.syncToClusters(clusterName, work)
@@ -223,6 +318,12 @@ func (c *Controller) syncToClusters(clusterName string, work *workv1alpha1.Work)
 }
 
 func (c *Controller) tryCreateOrUpdateWorkload(clusterName string, workload *unstructured.Unstructured) error {
+	if c.ApplyStrategy == objectwatcher.ServerSideApply {
+		// Server-Side Apply lets the API server resolve field ownership via managedFields,
+		// so there's no need to read the current state from cache first.
+		return c.ObjectWatcher.Apply(clusterName, workload, objectwatcher.ExecutionControllerFieldManager, c.ForceApply)
+	}
+
 	fedKey, err := keys.FederatedKeyFunc(clusterName, workload)
 	if err != nil {
 		klog.Errorf("Failed to get FederatedKey %s, error: %v", workload.GetName(), err)
@@ -283,3 +384,30 @@ func (c *Controller) eventf(object *unstructured.Unstructured, eventType, reason
 	}
 	c.EventRecorder.Eventf(ref, eventType, reason, messageFmt, args...)
 }
+
+// logOnlyEventRecorder is a record.EventRecorder that never talks to the API server. It is
+// swapped in for the normal broadcaster-backed recorder when the controller's startup probe
+// finds that it can't create events, so a restricted member cluster keeps the same event
+// reasons flowing to klog and metrics instead of retrying a doomed write forever.
+type logOnlyEventRecorder struct{}
+
+func (r *logOnlyEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	eventFallbackTotal.Inc()
+	klog.V(4).Infof("Event(%s): type: %s reason: %s message: %s", describeObject(object), eventtype, reason, message)
+}
+
+func (r *logOnlyEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *logOnlyEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func describeObject(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return object.GetObjectKind().GroupVersionKind().String()
+	}
+	return fmt.Sprintf("%s %s/%s", object.GetObjectKind().GroupVersionKind().Kind, accessor.GetNamespace(), accessor.GetName())
+}
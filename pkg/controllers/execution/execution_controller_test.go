@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/objectwatcher"
+)
+
+// fakeObjectWatcher is a minimal ObjectWatcher stub that records the calls made to it
+// and lets tests script the error returned from Apply.
+type fakeObjectWatcher struct {
+	applyCalls   int
+	applyErr     error
+	fieldManager string
+	force        bool
+}
+
+func (f *fakeObjectWatcher) Create(string, *unstructured.Unstructured) error { return nil }
+
+func (f *fakeObjectWatcher) Update(string, *unstructured.Unstructured, *unstructured.Unstructured) error {
+	return nil
+}
+
+func (f *fakeObjectWatcher) Apply(_ string, _ *unstructured.Unstructured, fieldManager string, force bool) error {
+	f.applyCalls++
+	f.fieldManager = fieldManager
+	f.force = force
+	return f.applyErr
+}
+
+func (f *fakeObjectWatcher) Delete(string, *unstructured.Unstructured) error { return nil }
+
+func newTestWorkload() *unstructured.Unstructured {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+	workload.SetNamespace("test")
+	workload.SetName("cm")
+	return workload
+}
+
+func TestTryCreateOrUpdateWorkload_ServerSideApply(t *testing.T) {
+	watcher := &fakeObjectWatcher{}
+	c := &Controller{
+		ObjectWatcher: watcher,
+		ApplyStrategy: objectwatcher.ServerSideApply,
+		ForceApply:    true,
+	}
+
+	if err := c.tryCreateOrUpdateWorkload("member1", newTestWorkload()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if watcher.applyCalls != 1 {
+		t.Fatalf("expected Apply to be called once, got %d", watcher.applyCalls)
+	}
+	if watcher.fieldManager != objectwatcher.ExecutionControllerFieldManager {
+		t.Fatalf("expected field manager %q, got %q", objectwatcher.ExecutionControllerFieldManager, watcher.fieldManager)
+	}
+	if !watcher.force {
+		t.Fatalf("expected force to be propagated as true")
+	}
+}
+
+func TestTryCreateOrUpdateWorkload_ServerSideApplyConflict(t *testing.T) {
+	conflictErr := apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "cm", errors.New("conflicting field manager"))
+	watcher := &fakeObjectWatcher{applyErr: conflictErr}
+	c := &Controller{
+		ObjectWatcher: watcher,
+		ApplyStrategy: objectwatcher.ServerSideApply,
+	}
+
+	err := c.tryCreateOrUpdateWorkload("member1", newTestWorkload())
+	if err == nil {
+		t.Fatalf("expected a conflict error to be returned")
+	}
+	if !apierrors.IsConflict(err) {
+		t.Fatalf("expected a conflict error, got: %v", err)
+	}
+	if watcher.force {
+		t.Fatalf("expected force to default to false when ForceApply is unset")
+	}
+}
+
+func manifestFor(t *testing.T, name string) workv1alpha1.Manifest {
+	t.Helper()
+
+	workload := newTestWorkload()
+	workload.SetName(name)
+	raw, err := workload.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to marshal workload %q: %v", name, err)
+	}
+	return workv1alpha1.Manifest{RawExtension: runtime.RawExtension{Raw: raw}}
+}
+
+func TestSyncWork_PartialSSAFailurePropagatesAppliedCondition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := workv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register scheme: %v", err)
+	}
+
+	work := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-es-member1", Name: "demo-work"},
+		Spec: workv1alpha1.WorkSpec{
+			Workload: workv1alpha1.WorkloadTemplate{
+				Manifests: []workv1alpha1.Manifest{manifestFor(t, "good"), manifestFor(t, "bad")},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(work).
+		WithStatusSubresource(&workv1alpha1.Work{}).
+		Build()
+
+	callCount := 0
+	c := &Controller{
+		Client:        fakeClient,
+		EventRecorder: &logOnlyEventRecorder{},
+		ObjectWatcher: applyFailOnSecondCall{count: &callCount},
+		ApplyStrategy: objectwatcher.ServerSideApply,
+	}
+
+	if _, err := c.syncWork("member1", work); err == nil {
+		t.Fatalf("expected syncWork to return an error when one of two SSA applies fails")
+	}
+
+	updated := &workv1alpha1.Work{}
+	if err := fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(work), updated); err != nil {
+		t.Fatalf("failed to fetch updated work: %v", err)
+	}
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, workv1alpha1.WorkApplied)
+	if cond == nil {
+		t.Fatalf("expected a %s condition to be set on the work", workv1alpha1.WorkApplied)
+	}
+	if cond.Status != metav1.ConditionFalse {
+		t.Fatalf("expected %s=False after a partial SSA failure, got %s", workv1alpha1.WorkApplied, cond.Status)
+	}
+	if cond.Reason != "AppliedFailed" {
+		t.Fatalf("expected reason AppliedFailed, got %s", cond.Reason)
+	}
+	if !strings.Contains(cond.Message, "1/2") {
+		t.Fatalf("expected condition message to report the partial failure count (1/2), got %q", cond.Message)
+	}
+}
+
+// TestTryCreateOrUpdateWorkload_ModeSwitchFieldOwnershipHandoff covers the transition an
+// operator hits when flipping an already-synced object from ClientSideUpdate to
+// ServerSideApply: every field is still owned by the prior Update-based manager, so the
+// first unforced apply is rejected until ForceApply hands ownership over.
+func TestTryCreateOrUpdateWorkload_ModeSwitchFieldOwnershipHandoff(t *testing.T) {
+	conflictErr := apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "cm", errors.New("conflict: field is managed by another manager"))
+	watcher := &fakeObjectWatcher{applyErr: conflictErr}
+	c := &Controller{
+		ObjectWatcher: watcher,
+		ApplyStrategy: objectwatcher.ServerSideApply,
+	}
+
+	if err := c.tryCreateOrUpdateWorkload("member1", newTestWorkload()); !apierrors.IsConflict(err) {
+		t.Fatalf("expected a conflict on the first unforced SSA reconcile after a mode switch, got %v", err)
+	}
+
+	watcher.applyErr = nil
+	c.ForceApply = true
+	if err := c.tryCreateOrUpdateWorkload("member1", newTestWorkload()); err != nil {
+		t.Fatalf("unexpected error once ForceApply hands ownership over: %v", err)
+	}
+	if !watcher.force {
+		t.Fatalf("expected force to be propagated to ObjectWatcher.Apply")
+	}
+}
+
+// applyFailOnSecondCall fails only the second Apply call, modeling a manifest list where
+// one resource is rejected while the rest of the Server-Side Apply patches succeed.
+type applyFailOnSecondCall struct {
+	count *int
+}
+
+func (a applyFailOnSecondCall) Create(string, *unstructured.Unstructured) error { return nil }
+
+func (a applyFailOnSecondCall) Update(string, *unstructured.Unstructured, *unstructured.Unstructured) error {
+	return nil
+}
+
+func (a applyFailOnSecondCall) Apply(string, *unstructured.Unstructured, string, bool) error {
+	*a.count++
+	if *a.count == 2 {
+		return apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "bad", errors.New("conflict"))
+	}
+	return nil
+}
+
+func (a applyFailOnSecondCall) Delete(string, *unstructured.Unstructured) error { return nil }
+
+func TestProbeEventPermission_Forbidden(t *testing.T) {
+	clientset := kubefake.NewSimpleClientset()
+	clientset.PrependReactor("create", "events", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "events"}, "", errors.New("events.k8s.io is forbidden"))
+	})
+
+	c := &Controller{KubeClientSet: clientset}
+	if c.probeEventPermission() {
+		t.Fatalf("expected probeEventPermission to report false on Forbidden")
+	}
+}
+
+func TestProbeEventPermission_Allowed(t *testing.T) {
+	c := &Controller{KubeClientSet: kubefake.NewSimpleClientset()}
+	if !c.probeEventPermission() {
+		t.Fatalf("expected probeEventPermission to report true when the dry-run create succeeds")
+	}
+}
+
+func TestLogOnlyEventRecorder_DoesNotPanic(t *testing.T) {
+	recorder := &logOnlyEventRecorder{}
+	work := &corev1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-es-member1", Name: "demo-work"}}
+
+	// These must not reach out to any client; a panic or a hang would fail the test.
+	recorder.Event(work, corev1.EventTypeWarning, "SyncWorkloadFailed", "boom")
+	recorder.Eventf(work, corev1.EventTypeNormal, "SyncWorkloadSucceed", "applied %d manifests", 2)
+	recorder.AnnotatedEventf(work, nil, corev1.EventTypeNormal, "SyncWorkloadSucceed", "applied %d manifests", 3)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectwatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyStrategy determines how a desired workload is reconciled against a member cluster.
+type ApplyStrategy string
+
+const (
+	// ClientSideUpdate is the default strategy: the object is fetched from the member
+	// cluster cache and either Created (if absent) or Updated (if present) by the caller.
+	ClientSideUpdate ApplyStrategy = "ClientSideUpdate"
+
+	// ServerSideApply reconciles the object with a Server-Side Apply patch, letting the
+	// API server own conflict detection via managedFields instead of a client-side diff.
+	ServerSideApply ApplyStrategy = "ServerSideApply"
+)
+
+// ExecutionControllerFieldManager is the field manager used when the execution controller
+// applies manifests to member clusters with ServerSideApply.
+const ExecutionControllerFieldManager = "karmada-execution-controller"
+
+// ClusterClientSetFunc returns a client for the given member cluster.
+type ClusterClientSetFunc func(clusterName string, client client.Client) (client.Client, error)
+
+// ObjectWatcher manages operations for object dispatched to member clusters.
+type ObjectWatcher interface {
+	Create(clusterName string, desireObj *unstructured.Unstructured) error
+	Update(clusterName string, desireObj, clusterObj *unstructured.Unstructured) error
+	// Apply reconciles desireObj in the given member cluster using a Server-Side Apply
+	// patch, fencing field ownership to fieldManager. force mirrors the Apply force-conflicts
+	// flag: when true, conflicting field managers are overridden instead of returning a conflict error.
+	Apply(clusterName string, desireObj *unstructured.Unstructured, fieldManager string, force bool) error
+	Delete(clusterName string, desireObj *unstructured.Unstructured) error
+}
+
+type objectWatcherImpl struct {
+	Lock                 sync.RWMutex
+	KubeClient           client.Client
+	RESTMapper           meta.RESTMapper
+	ClusterClientSetFunc ClusterClientSetFunc
+	VersionRecord        map[string]map[string]string
+}
+
+// NewObjectWatcher returns an instance of ObjectWatcher.
+func NewObjectWatcher(kubeClient client.Client, restMapper meta.RESTMapper, clusterClientSetFunc ClusterClientSetFunc) ObjectWatcher {
+	return &objectWatcherImpl{
+		KubeClient:           kubeClient,
+		RESTMapper:           restMapper,
+		ClusterClientSetFunc: clusterClientSetFunc,
+		VersionRecord:        make(map[string]map[string]string),
+	}
+}
+
+func (o *objectWatcherImpl) Create(clusterName string, desireObj *unstructured.Unstructured) error {
+	clusterClient, err := o.ClusterClientSetFunc(clusterName, o.KubeClient)
+	if err != nil {
+		return err
+	}
+
+	clusterObj := desireObj.DeepCopy()
+	if err := clusterClient.Create(context.TODO(), clusterObj); err != nil {
+		klog.Errorf("Failed to create resource(kind=%s, %s/%s) in cluster %s: %v", desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), clusterName, err)
+		return err
+	}
+
+	o.recordVersion(clusterObj, clusterName)
+	return nil
+}
+
+func (o *objectWatcherImpl) Update(clusterName string, desireObj, clusterObj *unstructured.Unstructured) error {
+	clusterClient, err := o.ClusterClientSetFunc(clusterName, o.KubeClient)
+	if err != nil {
+		return err
+	}
+
+	desireObj.SetResourceVersion(clusterObj.GetResourceVersion())
+	if err := clusterClient.Update(context.TODO(), desireObj); err != nil {
+		klog.Errorf("Failed to update resource(kind=%s, %s/%s) in cluster %s: %v", desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), clusterName, err)
+		return err
+	}
+
+	o.recordVersion(desireObj, clusterName)
+	return nil
+}
+
+// Apply reconciles desireObj against the member cluster with a Server-Side Apply patch,
+// skipping the cache read that Create/Update rely on. Field ownership is tracked by the API
+// server via managedFields, whereas Update replaces the whole object at the cluster's resourceVersion.
+func (o *objectWatcherImpl) Apply(clusterName string, desireObj *unstructured.Unstructured, fieldManager string, force bool) error {
+	clusterClient, err := o.ClusterClientSetFunc(clusterName, o.KubeClient)
+	if err != nil {
+		return err
+	}
+
+	if fieldManager == "" {
+		fieldManager = ExecutionControllerFieldManager
+	}
+
+	applyObj := desireObj.DeepCopy()
+	patchOpts := []client.PatchOption{client.FieldOwner(fieldManager)}
+	if force {
+		patchOpts = append(patchOpts, client.ForceOwnership)
+	}
+
+	if err := clusterClient.Patch(context.TODO(), applyObj, client.Apply, patchOpts...); err != nil {
+		if apierrors.IsConflict(err) {
+			klog.Errorf("Field conflict applying resource(kind=%s, %s/%s) to cluster %s with manager %q: %v", desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), clusterName, fieldManager, err)
+			return err
+		}
+		klog.Errorf("Failed to apply resource(kind=%s, %s/%s) to cluster %s: %v", desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), clusterName, err)
+		return err
+	}
+
+	o.recordVersion(applyObj, clusterName)
+	return nil
+}
+
+func (o *objectWatcherImpl) Delete(clusterName string, desireObj *unstructured.Unstructured) error {
+	clusterClient, err := o.ClusterClientSetFunc(clusterName, o.KubeClient)
+	if err != nil {
+		return err
+	}
+
+	if err := clusterClient.Delete(context.TODO(), desireObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		klog.Errorf("Failed to delete resource(kind=%s, %s/%s) in cluster %s: %v", desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), clusterName, err)
+		return err
+	}
+
+	o.deleteVersionRecord(desireObj, clusterName)
+	return nil
+}
+
+func (o *objectWatcherImpl) recordVersion(clusterObj *unstructured.Unstructured, clusterName string) {
+	o.Lock.Lock()
+	defer o.Lock.Unlock()
+
+	key := objectKey(clusterObj)
+	if o.VersionRecord[clusterName] == nil {
+		o.VersionRecord[clusterName] = make(map[string]string)
+	}
+	o.VersionRecord[clusterName][key] = clusterObj.GetResourceVersion()
+}
+
+func (o *objectWatcherImpl) deleteVersionRecord(clusterObj *unstructured.Unstructured, clusterName string) {
+	o.Lock.Lock()
+	defer o.Lock.Unlock()
+
+	delete(o.VersionRecord[clusterName], objectKey(clusterObj))
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}